@@ -0,0 +1,130 @@
+package ringbuffer
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrBufferFull is returned by ReadSlice when delim isn't found and the
+// ring-buffer has no free space left to receive more data.
+var ErrBufferFull = errors.New("ring-buffer is full")
+
+// ErrDelimNotFound is returned by ReadSlice/ReadBytes when delim hasn't
+// been written yet but the buffer still has room for more data.
+var ErrDelimNotFound = errors.New("ring-buffer: delimiter not found")
+
+// ErrBufferTooSmall is returned by Peek when fewer than n bytes are
+// currently buffered, even if the buffer isn't entirely empty.
+var ErrBufferTooSmall = errors.New("ring-buffer: not enough buffered data")
+
+// Peek returns the next n bytes without advancing the read pointer, as a
+// single contiguous slice. If the requested region wraps around the end
+// of the underlying array, Peek linearizes the buffered data in place so
+// the result is always one slice instead of two.
+func (rb *RingBuffer) Peek(n int) ([]byte, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	if rb.isEmpty {
+		return nil, ErrIsEmpty
+	}
+	if rb.Length() < n {
+		return nil, ErrBufferTooSmall
+	}
+
+	head, tail := rb.LazyRead(n)
+	if len(tail) == 0 {
+		return head, nil
+	}
+
+	rb.linearize()
+	head, _ = rb.LazyRead(n)
+	return head, nil
+}
+
+// Discard skips the next n buffered bytes, advancing the read pointer. If
+// fewer than n bytes are buffered, it discards whatever is available and
+// returns ErrIsEmpty.
+func (rb *RingBuffer) Discard(n int) (int, error) {
+	if n <= 0 {
+		return 0, nil
+	}
+
+	discarded := n
+	var err error
+	if avail := rb.Length(); n > avail {
+		discarded = avail
+		err = ErrIsEmpty
+	}
+
+	rb.Shift(discarded)
+	return discarded, err
+}
+
+// ReadSlice reads until the first occurrence of delim, returning a slice
+// pointing directly at the buffered bytes up to and including delim, and
+// advances the read pointer past it. The slice is only valid until the
+// next call that mutates the buffer; use ReadBytes for an owned copy.
+//
+// If delim isn't found, ReadSlice returns ErrBufferFull when the buffer is
+// full, or ErrDelimNotFound if there's still room for delim to arrive
+// later.
+func (rb *RingBuffer) ReadSlice(delim byte) ([]byte, error) {
+	head, tail := rb.LazyReadAll()
+
+	if i := bytes.IndexByte(head, delim); i >= 0 {
+		n := i + 1
+		line := head[:n]
+		rb.Shift(n)
+		return line, nil
+	}
+
+	if i := bytes.IndexByte(tail, delim); i >= 0 {
+		n := len(head) + i + 1
+		rb.linearize()
+		line := rb.buf[:n]
+		rb.Shift(n)
+		return line, nil
+	}
+
+	if rb.IsFull() {
+		rb.linearize()
+		all := rb.buf[:rb.Length()]
+		rb.Shift(len(all))
+		return all, ErrBufferFull
+	}
+
+	return nil, ErrDelimNotFound
+}
+
+// ReadBytes reads until the first occurrence of delim, returning a newly
+// allocated slice containing the data up to and including delim. See
+// ReadSlice for the possible errors.
+func (rb *RingBuffer) ReadBytes(delim byte) ([]byte, error) {
+	slice, err := rb.ReadSlice(delim)
+	buf := make([]byte, len(slice))
+	copy(buf, slice)
+	return buf, err
+}
+
+// linearize rotates the buffered data, via a scratch copy, so the read
+// pointer sits at index 0 and all of it is contiguous in rb.buf, without
+// discarding any of it.
+func (rb *RingBuffer) linearize() {
+	head, tail := rb.LazyReadAll()
+	length := len(head) + len(tail)
+	if length == 0 {
+		return
+	}
+
+	scratch := make([]byte, length)
+	copy(scratch, head)
+	copy(scratch[len(head):], tail)
+	copy(rb.buf, scratch)
+
+	rb.r = 0
+	rb.w = length
+	if rb.w == rb.size {
+		rb.w = 0
+	}
+}
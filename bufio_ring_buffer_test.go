@@ -0,0 +1,89 @@
+package ringbuffer
+
+import "testing"
+
+func TestRingBufferPeekLinearizesWrappedRegion(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abcdef"))
+	rb.Shift(4) // leaves "ef", so the next write wraps the write pointer
+	rb.Write([]byte("ghij"))
+
+	got, err := rb.Peek(6)
+	if err != nil || string(got) != "efghij" {
+		t.Fatalf("Peek() = (%q, %v), want (\"efghij\", nil)", got, err)
+	}
+	if rb.Length() != 6 {
+		t.Fatalf("Peek() advanced the read pointer: Length() = %d, want 6", rb.Length())
+	}
+}
+
+func TestRingBufferPeekInsufficientData(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("ab"))
+
+	if _, err := rb.Peek(4); err != ErrBufferTooSmall {
+		t.Fatalf("Peek() error = %v, want ErrBufferTooSmall", err)
+	}
+}
+
+func TestRingBufferPeekEmpty(t *testing.T) {
+	rb := NewRingBuffer(8)
+	if _, err := rb.Peek(1); err != ErrIsEmpty {
+		t.Fatalf("Peek() error = %v, want ErrIsEmpty", err)
+	}
+}
+
+func TestRingBufferDiscard(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abcdef"))
+
+	n, err := rb.Discard(2)
+	if err != nil || n != 2 {
+		t.Fatalf("Discard() = (%d, %v), want (2, nil)", n, err)
+	}
+
+	buf := make([]byte, 8)
+	rn, _ := rb.Read(buf)
+	if string(buf[:rn]) != "cdef" {
+		t.Fatalf("Read() after Discard = %q, want \"cdef\"", buf[:rn])
+	}
+}
+
+func TestRingBufferReadSliceAcrossSegments(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abcdef"))
+	rb.Shift(4) // leaves "ef", so the delimiter below lands in the wrapped tail segment
+	rb.Write([]byte("gh\nij"))
+
+	line, err := rb.ReadSlice('\n')
+	if err != nil || string(line) != "efgh\n" {
+		t.Fatalf("ReadSlice() = (%q, %v), want (\"efgh\\n\", nil)", line, err)
+	}
+
+	rest, err := rb.ReadBytes('j')
+	if err != nil || string(rest) != "ij" {
+		t.Fatalf("ReadBytes() = (%q, %v), want (\"ij\", nil)", rest, err)
+	}
+}
+
+func TestRingBufferReadSliceDelimNotFound(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abc"))
+
+	if _, err := rb.ReadSlice('\n'); err != ErrDelimNotFound {
+		t.Fatalf("ReadSlice() error = %v, want ErrDelimNotFound", err)
+	}
+}
+
+func TestRingBufferReadSliceFullWithNoDelim(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abcdefgh"))
+
+	line, err := rb.ReadSlice('\n')
+	if err != ErrBufferFull || string(line) != "abcdefgh" {
+		t.Fatalf("ReadSlice() = (%q, %v), want (\"abcdefgh\", ErrBufferFull)", line, err)
+	}
+	if !rb.IsEmpty() {
+		t.Fatalf("IsEmpty() = false after ReadSlice drained the full buffer")
+	}
+}
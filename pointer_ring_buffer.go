@@ -0,0 +1,276 @@
+package ringbuffer
+
+import "unsafe"
+
+// minPointerInitCount is the floor on a freshly grown, empty
+// PointerRingBuffer[T]'s first capacity, regardless of how large T is.
+const minPointerInitCount = 16
+
+// PointerRingBuffer is the generic, non-byte-oriented counterpart to
+// RingBuffer. It reuses the same power-of-two mask arithmetic and
+// auto-grow strategy, so it serves as a general work queue / event ring
+// for structs (e.g. network packets, task descriptors) instead of bytes.
+type PointerRingBuffer[T any] struct {
+	buf []T
+
+	size int
+
+	r int
+	w int
+
+	mask    int
+	isEmpty bool
+}
+
+// NewPointerRingBuffer creates a PointerRingBuffer.
+func NewPointerRingBuffer[T any](size int) *PointerRingBuffer[T] {
+	rb := &PointerRingBuffer[T]{isEmpty: true}
+	if size <= 0 {
+		return rb
+	}
+	rb.buf = make([]T, size)
+	rb.size = size
+	rb.mask = size - 1
+	return rb
+}
+
+// Push appends v, growing the buffer if it's currently full.
+func (rb *PointerRingBuffer[T]) Push(v T) {
+	if rb.Free() < 1 {
+		rb.malloc(1)
+	}
+
+	rb.buf[rb.w] = v
+	rb.w++
+	if rb.w == rb.size {
+		rb.w = 0
+	}
+
+	rb.isEmpty = false
+}
+
+// PushN appends vs, growing the buffer if needed to fit all of them.
+func (rb *PointerRingBuffer[T]) PushN(vs []T) {
+	n := len(vs)
+	if n == 0 {
+		return
+	}
+
+	if free := rb.Free(); free < n {
+		rb.malloc(n - free)
+	}
+
+	if rb.w >= rb.r {
+		c1 := rb.size - rb.w
+		if c1 >= n {
+			copy(rb.buf[rb.w:], vs)
+			rb.w += n
+		} else {
+			copy(rb.buf[rb.w:], vs[:c1])
+			c2 := n - c1
+			copy(rb.buf[:c2], vs[c1:])
+			rb.w = c2
+		}
+	} else {
+		copy(rb.buf[rb.w:], vs)
+		rb.w += n
+	}
+
+	if rb.w == rb.size {
+		rb.w = 0
+	}
+
+	rb.isEmpty = false
+}
+
+// Pop removes and returns the oldest element, or the zero value and false
+// if the buffer is empty.
+func (rb *PointerRingBuffer[T]) Pop() (v T, ok bool) {
+	if rb.isEmpty {
+		return v, false
+	}
+
+	v = rb.buf[rb.r]
+
+	var zero T
+	rb.buf[rb.r] = zero // don't keep T's references reachable past the pop
+
+	rb.r++
+	if rb.r == rb.size {
+		rb.r = 0
+	}
+	if rb.r == rb.w {
+		rb.isEmpty = true
+	}
+
+	return v, true
+}
+
+// PopN removes up to n elements, returning them as two contiguous
+// segments (head then tail) to avoid copying.
+func (rb *PointerRingBuffer[T]) PopN(n int) (head, tail []T) {
+	if rb.isEmpty || n <= 0 {
+		return
+	}
+
+	if rb.w > rb.r {
+		m := rb.w - rb.r
+		if m > n {
+			m = n
+		}
+		head = rb.buf[rb.r : rb.r+m]
+		rb.Shift(m)
+		return
+	}
+
+	m := rb.size - rb.r + rb.w
+	if m > n {
+		m = n
+	}
+
+	if rb.size >= rb.r+m {
+		head = rb.buf[rb.r : rb.r+m]
+	} else {
+		head = rb.buf[rb.r:]
+		x1 := (rb.r + m) - rb.size
+		tail = rb.buf[:x1]
+	}
+
+	rb.Shift(m)
+	return
+}
+
+// TwoContig returns the two contiguous segments covering all currently
+// buffered elements, without advancing the read pointer, for zero-copy
+// iteration.
+func (rb *PointerRingBuffer[T]) TwoContig() (head, tail []T) {
+	if rb.isEmpty {
+		return
+	}
+
+	if rb.w > rb.r {
+		head = rb.buf[rb.r:rb.w]
+		return
+	}
+
+	head = rb.buf[rb.r:]
+	if rb.w != 0 {
+		tail = rb.buf[:rb.w]
+	}
+	return
+}
+
+// Shift advances the read pointer by n, marking the buffer empty once it
+// catches up to the write pointer.
+func (rb *PointerRingBuffer[T]) Shift(n int) {
+	if n <= 0 {
+		return
+	}
+
+	if n < rb.Length() {
+		rb.r = (rb.r + n) & rb.mask
+		return
+	}
+
+	rb.Reset()
+}
+
+// Reset clears the read and write pointers and marks the buffer empty,
+// without reallocating the underlying buffer.
+func (rb *PointerRingBuffer[T]) Reset() {
+	rb.isEmpty = true
+	rb.r = 0
+	rb.w = 0
+}
+
+// IsEmpty reports whether the buffer holds no elements.
+func (rb *PointerRingBuffer[T]) IsEmpty() bool {
+	return rb.isEmpty
+}
+
+// IsFull reports whether the buffer has no room left.
+func (rb *PointerRingBuffer[T]) IsFull() bool {
+	return rb.w == rb.r && !rb.isEmpty
+}
+
+// Free returns how many more elements can be pushed before growing.
+func (rb *PointerRingBuffer[T]) Free() int {
+	if rb.r == rb.w {
+		if rb.isEmpty {
+			return rb.size
+		}
+		return 0
+	}
+
+	if rb.w < rb.r {
+		return rb.r - rb.w
+	}
+
+	return (rb.size - rb.w) + rb.r
+}
+
+// Length returns the number of buffered elements.
+func (rb *PointerRingBuffer[T]) Length() int {
+	if rb.r == rb.w {
+		if rb.isEmpty {
+			return 0
+		}
+		return rb.size
+	}
+
+	if rb.w > rb.r {
+		return rb.w - rb.r
+	}
+
+	return (rb.size - rb.r) + rb.w
+}
+
+// Len returns the length of the underlying buffer.
+func (rb *PointerRingBuffer[T]) Len() int {
+	return len(rb.buf)
+}
+
+// Cap returns the buffer's capacity.
+func (rb *PointerRingBuffer[T]) Cap() int {
+	return rb.size
+}
+
+// malloc grows the buffer to fit at least cap more elements.
+func (rb *PointerRingBuffer[T]) malloc(cap int) {
+	var newCap int
+	if rb.size == 0 && cap < minPointerInitCount {
+		newCap = CeilToPowerOfTwo(rb.firstCap())
+	} else {
+		newCap = CeilToPowerOfTwo(rb.size + cap)
+	}
+
+	newBuf := make([]T, newCap)
+
+	oldLen := rb.Length()
+	head, tail := rb.TwoContig()
+	n := copy(newBuf, head)
+	copy(newBuf[n:], tail)
+
+	rb.buf = newBuf
+	rb.size = newCap
+	rb.mask = newCap - 1
+	rb.r = 0
+	rb.w = oldLen
+}
+
+// firstCap scales initSize's 4096-byte footprint target by sizeof(T),
+// instead of reusing it as a literal element count, which would allocate
+// megabytes up front for anything but tiny T.
+func (rb *PointerRingBuffer[T]) firstCap() int {
+	var zero T
+	elemSize := int(unsafe.Sizeof(zero))
+	if elemSize == 0 {
+		elemSize = 1
+	}
+
+	n := initSize / elemSize
+	if n < minPointerInitCount {
+		n = minPointerInitCount
+	}
+	return n
+}
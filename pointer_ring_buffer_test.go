@@ -0,0 +1,75 @@
+package ringbuffer
+
+import "testing"
+
+func TestPointerRingBufferPushPop(t *testing.T) {
+	rb := NewPointerRingBuffer[int](4)
+
+	rb.Push(1)
+	rb.Push(2)
+	rb.Push(3)
+
+	if rb.Length() != 3 {
+		t.Fatalf("Length() = %d, want 3", rb.Length())
+	}
+
+	for _, want := range []int{1, 2, 3} {
+		v, ok := rb.Pop()
+		if !ok || v != want {
+			t.Fatalf("Pop() = (%d, %v), want (%d, true)", v, ok, want)
+		}
+	}
+
+	if _, ok := rb.Pop(); ok {
+		t.Fatalf("Pop() on empty buffer = ok, want false")
+	}
+}
+
+func TestPointerRingBufferPushGrowsWhenFull(t *testing.T) {
+	rb := NewPointerRingBuffer[int](2)
+
+	rb.Push(1)
+	rb.Push(2)
+	rb.Push(3) // forces malloc since the buffer is full
+
+	if rb.Cap() <= 2 {
+		t.Fatalf("Cap() = %d, want it to have grown past 2", rb.Cap())
+	}
+
+	for _, want := range []int{1, 2, 3} {
+		v, ok := rb.Pop()
+		if !ok || v != want {
+			t.Fatalf("Pop() = (%d, %v), want (%d, true)", v, ok, want)
+		}
+	}
+}
+
+func TestPointerRingBufferFirstCapScalesWithElementSize(t *testing.T) {
+	type big [1024]byte
+
+	rb := NewPointerRingBuffer[big](0)
+	rb.Push(big{})
+
+	if rb.Cap() > 16 {
+		t.Fatalf("Cap() = %d, want a small element count for a large T, not initSize reused as element count", rb.Cap())
+	}
+}
+
+func TestPointerRingBufferPopNAcrossSegments(t *testing.T) {
+	rb := NewPointerRingBuffer[int](4)
+	rb.PushN([]int{1, 2, 3})
+	rb.Shift(2) // r=2, w=3
+	rb.PushN([]int{4, 5, 6})
+
+	head, tail := rb.PopN(4)
+	got := append(append([]int{}, head...), tail...)
+	want := []int{3, 4, 5, 6}
+	if len(got) != len(want) {
+		t.Fatalf("PopN() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("PopN() = %v, want %v", got, want)
+		}
+	}
+}
@@ -12,9 +12,19 @@ import (
 
 const initSize = 1 << 12 // 4096 bytes for the first-time allocation on ring-buffer.
 
+// minShrinkStreak is how many consecutive low-water drains TryShrink
+// requires before it actually shrinks the buffer.
+const minShrinkStreak = 3
+
 // ErrIsEmpty will be returned when trying to read a empty ring-buffer.
 var ErrIsEmpty = errors.New("ring-buffer is empty")
 
+// ErrMaxSizeExceeded is returned by Write/WriteByte when growing the
+// buffer to fit the request would exceed the MaxSize configured via
+// NewRingBufferWithOptions. As with io.Writer, n may be less than the
+// requested length when this is returned.
+var ErrMaxSizeExceeded = errors.New("ring-buffer: max size exceeded")
+
 type RingBuffer struct {
 	//缓存区
 	buf []byte
@@ -30,13 +40,44 @@ type RingBuffer struct {
 	mask int
 	//是否为空
 	isEmpty bool
+
+	//扩容上限，0表示不设上限
+	maxSize int
+	//自动收缩的低水位阈值（size的比例），0表示关闭自动收缩
+	shrinkThreshold float64
+	//是否禁用自动收缩
+	disableAutoShrink bool
+	//连续低于低水位阈值的次数
+	lowWaterStreak int
+
+	//Bytes()用于线性化的备用缓存区，与buf大小相同，乒乓复用
+	alt []byte
+}
+
+// Options configures growth and shrink behavior for a RingBuffer created
+// via NewRingBufferWithOptions.
+type Options struct {
+	// MaxSize caps how large auto-grow (via Write/WriteByte) may take the
+	// buffer; growth is rounded up to a power of two same as ordinary
+	// auto-grow, so the effective ceiling is CeilToPowerOfTwo(MaxSize).
+	// Once hit, Write/WriteByte return ErrMaxSizeExceeded with a short
+	// write rather than growing further. Zero means unbounded, matching
+	// NewRingBuffer.
+	MaxSize int
+	// ShrinkThreshold is the low-water fraction of Cap() that Length() must
+	// stay under, for minShrinkStreak consecutive drains, before the buffer
+	// is automatically shrunk. Zero disables automatic shrinking, same as
+	// NewRingBuffer.
+	ShrinkThreshold float64
+	// DisableAutoShrink turns off the automatic shrink check made after
+	// each drain, leaving Shrink/TryShrink available to call manually.
+	DisableAutoShrink bool
 }
 
 //创建RingBuffer
 func NewRingBuffer(size int) *RingBuffer {
 	rb := &RingBuffer{
 		isEmpty: true,
-		w:       50,
 	}
 	if size <= 0 {
 		return rb
@@ -47,6 +88,16 @@ func NewRingBuffer(size int) *RingBuffer {
 	return rb
 }
 
+// NewRingBufferWithOptions creates a RingBuffer like NewRingBuffer but with
+// a configurable growth cap and opt-in automatic shrinking.
+func NewRingBufferWithOptions(size int, opts Options) *RingBuffer {
+	rb := NewRingBuffer(size)
+	rb.maxSize = opts.MaxSize
+	rb.shrinkThreshold = opts.ShrinkThreshold
+	rb.disableAutoShrink = opts.DisableAutoShrink
+	return rb
+}
+
 // LazyRead reads the bytes with given length but will not move the pointer of "read".
 func (rb *RingBuffer) LazyRead(rlen int) (head, tail []byte) {
 	//buf为空或参数小于等于0 return
@@ -99,7 +150,11 @@ func (rb *RingBuffer) LazyReadAll() (head []byte, tail []byte) {
 	return
 }
 
-// Shift shifts the "read" pointer.
+// Shift advances the "read" pointer by n, marking the buffer empty once it
+// catches up to the write pointer. It does not reallocate by itself: space
+// is reclaimed only by calling Shrink or TryShrink explicitly, or, when
+// auto-shrink is configured (see NewRingBufferWithOptions), by the
+// TryShrink check that a full drain triggers.
 func (rb *RingBuffer) Shift(n int) {
 	if n <= 0 {
 		return
@@ -107,9 +162,11 @@ func (rb *RingBuffer) Shift(n int) {
 
 	if n < rb.Length() {
 		rb.r = (rb.r + n) & rb.mask
-	} else {
-		rb.Reset()
+		return
 	}
+
+	rb.Reset()
+	rb.autoShrink()
 }
 
 func (rb *RingBuffer) Read(p []byte) (n int, err error) {
@@ -134,6 +191,7 @@ func (rb *RingBuffer) Read(p []byte) (n int, err error) {
 		rb.r += n
 		if rb.r == rb.w {
 			rb.Reset()
+			rb.autoShrink()
 		}
 		return
 	}
@@ -160,6 +218,7 @@ func (rb *RingBuffer) Read(p []byte) (n int, err error) {
 	rb.r = (rb.r + n) & rb.mask
 	if rb.r == rb.w {
 		rb.Reset()
+		rb.autoShrink()
 	}
 
 	return n, err
@@ -180,6 +239,7 @@ func (rb *RingBuffer) ReadByte() (b byte, err error) {
 		rb.r = 0
 	} else if rb.r == rb.w {
 		rb.Reset()
+		rb.autoShrink()
 	}
 	return
 }
@@ -191,12 +251,27 @@ func (rb *RingBuffer) Write(p []byte) (n int, err error) {
 		return 0, nil
 	}
 
-	free := rb.Free()
-	if free < n {
+	if free := rb.Free(); free < n {
 		//扩容
 		rb.malloc(n - free)
 	}
 
+	if free := rb.Free(); free < n {
+		// MaxSize capped growth short of what's needed; write what fits.
+		n = free
+		err = ErrMaxSizeExceeded
+	}
+
+	rb.copyIn(p[:n])
+
+	return n, err
+}
+
+// copyIn copies p into buf starting at the write pointer and advances it.
+// The caller must ensure rb.Free() >= len(p).
+func (rb *RingBuffer) copyIn(p []byte) {
+	n := len(p)
+
 	if rb.w >= rb.r {
 		//两段内存
 		c1 := rb.size - rb.w
@@ -220,8 +295,6 @@ func (rb *RingBuffer) Write(p []byte) (n int, err error) {
 	}
 
 	rb.isEmpty = false
-
-	return n, err
 }
 
 // WriteByte writes one byte into buffer.
@@ -229,6 +302,10 @@ func (rb *RingBuffer) WriteByte(p byte) (err error) {
 	if rb.Free() < 1 {
 		rb.malloc(1)
 	}
+	if rb.Free() < 1 {
+		// MaxSize capped growth; nothing was written.
+		return ErrMaxSizeExceeded
+	}
 
 	//已经做了防越界处理，直接插入即可
 	rb.buf[rb.w] = p
@@ -300,20 +377,71 @@ func (rb *RingBuffer) Cap() int {
 	return rb.size
 }
 
-// Reset the read pointer and writer pointer to zero. 重置 并缩小 buf
+// Reset clears the read and write pointers and marks the buffer empty,
+// without reallocating the underlying buffer. 重置读写位，不缩容
 func (rb *RingBuffer) Reset() {
 	//缓存区置空
 	rb.isEmpty = true
 	//读写标志位置零
 	rb.r = 0
 	rb.w = 0
+}
+
+// Shrink halves the underlying buffer in place, preserving any buffered
+// data. It is a no-op if the buffer is already at initSize or if Length()
+// wouldn't fit in the halved capacity.
+func (rb *RingBuffer) Shrink() {
+	if rb.size <= initSize {
+		return
+	}
 
-	//尺寸缩小一半
 	newCap := rb.size >> 1
+	if rb.Length() > newCap {
+		return
+	}
+
 	newBuf := make([]byte, newCap)
+	oldLen := rb.Length()
+	//读取旧缓存区数据
+	_, _ = rb.Read(newBuf)
 	rb.buf = newBuf
+
 	rb.size = newCap
 	rb.mask = newCap - 1
+	rb.r = 0
+	rb.w = oldLen
+	// rb.Read above drains the old buffer and, on hitting r==w, calls the
+	// non-reallocating Reset, which marks the buffer empty; restore the
+	// flag to match the data we just copied into the new buffer.
+	rb.isEmpty = oldLen == 0
+}
+
+// TryShrink calls Shrink once Length() has stayed under threshold (a
+// fraction of Cap() in the range (0, 1]) for minShrinkStreak consecutive
+// calls, resetting the streak as soon as that condition doesn't hold.
+func (rb *RingBuffer) TryShrink(threshold float64) {
+	if threshold <= 0 || rb.size == 0 || float64(rb.Length()) >= threshold*float64(rb.size) {
+		rb.lowWaterStreak = 0
+		return
+	}
+
+	rb.lowWaterStreak++
+	if rb.lowWaterStreak < minShrinkStreak {
+		return
+	}
+
+	rb.lowWaterStreak = 0
+	rb.Shrink()
+}
+
+// autoShrink runs TryShrink using the buffer's configured shrink threshold,
+// unless auto-shrink is disabled or no threshold was configured (the case
+// for buffers created via NewRingBuffer).
+func (rb *RingBuffer) autoShrink() {
+	if rb.disableAutoShrink || rb.shrinkThreshold <= 0 {
+		return
+	}
+	rb.TryShrink(rb.shrinkThreshold)
 }
 
 //扩容，分配内存
@@ -325,6 +453,19 @@ func (rb *RingBuffer) malloc(cap int) {
 		newCap = CeilToPowerOfTwo(rb.size + cap)
 	}
 
+	if rb.maxSize > 0 {
+		if ceiling := CeilToPowerOfTwo(rb.maxSize); newCap > ceiling {
+			newCap = ceiling
+		}
+	}
+
+	if newCap <= rb.size {
+		// Already at the configured ceiling; nothing more to grow. The
+		// caller (Write/WriteByte) re-checks Free() and reports
+		// ErrMaxSizeExceeded.
+		return
+	}
+
 	//扩容
 	newBuf := make([]byte, newCap)
 
@@ -0,0 +1,49 @@
+package ringbuffer
+
+// Bytes returns all readable data as a single contiguous slice, without
+// advancing the read pointer. If the data currently wraps around the end
+// of the underlying array, Bytes ping-pongs it into a second, equally
+// sized backing array (allocated lazily and reused across calls, rather
+// than a fresh scratch slice every time) and swaps the two, so future
+// calls alternate between them.
+func (rb *RingBuffer) Bytes() []byte {
+	head, tail := rb.LazyReadAll()
+	if len(tail) == 0 {
+		return head
+	}
+
+	if len(rb.alt) != rb.size {
+		rb.alt = make([]byte, rb.size)
+	}
+
+	n := copy(rb.alt, head)
+	n += copy(rb.alt[n:], tail)
+
+	rb.buf, rb.alt = rb.alt, rb.buf
+	rb.r = 0
+	rb.w = n
+	if rb.w == rb.size {
+		rb.w = 0
+	}
+
+	return rb.buf[:n]
+}
+
+// Adopt installs p as the ring-buffer's backing storage without copying,
+// marking all of it as immediately readable (r=0, and w=len(p) wrapped
+// around to 0, so a non-empty p leaves the buffer full). p's length
+// should be a power of two, matching the invariant malloc/Shrink maintain
+// for the rest of the ring's pointer arithmetic.
+func (rb *RingBuffer) Adopt(p []byte) {
+	rb.buf = p
+	rb.alt = nil
+	rb.size = len(p)
+	rb.mask = rb.size - 1
+	rb.r = 0
+	rb.w = len(p)
+	if rb.w == rb.size {
+		rb.w = 0
+	}
+	rb.isEmpty = len(p) == 0
+	rb.lowWaterStreak = 0
+}
@@ -0,0 +1,49 @@
+package ringbuffer
+
+import "testing"
+
+func TestRingBufferBytesLinearizesWrappedData(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abcdef"))
+	rb.Shift(4) // leaves "ef", so the next write wraps the write pointer
+	rb.Write([]byte("ghij"))
+
+	got := rb.Bytes()
+	if string(got) != "efghij" {
+		t.Fatalf("Bytes() = %q, want %q", got, "efghij")
+	}
+
+	// A second call should return the same contents via the ping-ponged
+	// buffer, without needing to wrap again.
+	got = rb.Bytes()
+	if string(got) != "efghij" {
+		t.Fatalf("Bytes() on second call = %q, want %q", got, "efghij")
+	}
+}
+
+func TestRingBufferAdoptWrapsWriteWhenFull(t *testing.T) {
+	rb := NewRingBuffer(0)
+	rb.Adopt(make([]byte, 8))
+
+	if !rb.IsFull() {
+		t.Fatalf("IsFull() = false after Adopt filled the whole buffer")
+	}
+	if rb.Length() != 8 {
+		t.Fatalf("Length() = %d, want 8", rb.Length())
+	}
+
+	buf := make([]byte, 8)
+	n, err := rb.Read(buf)
+	if err != nil || n != 8 {
+		t.Fatalf("Read() = (%d, %v), want (8, nil)", n, err)
+	}
+}
+
+func TestRingBufferAdoptEmpty(t *testing.T) {
+	rb := NewRingBuffer(0)
+	rb.Adopt(nil)
+
+	if !rb.IsEmpty() {
+		t.Fatalf("IsEmpty() = false after adopting an empty slice")
+	}
+}
@@ -0,0 +1,102 @@
+package ringbuffer
+
+import "io"
+
+// freeSegments returns the (up to two) contiguous writable regions starting
+// at the write pointer, covering all currently free bytes. It is the
+// write-side counterpart of LazyReadAll.
+func (rb *RingBuffer) freeSegments() (head, tail []byte) {
+	if rb.size == 0 {
+		return
+	}
+
+	if rb.r == rb.w {
+		if !rb.isEmpty {
+			return
+		}
+		return rb.buf[:rb.size], nil
+	}
+
+	if rb.w < rb.r {
+		return rb.buf[rb.w:rb.r], nil
+	}
+
+	head = rb.buf[rb.w:]
+	tail = rb.buf[:rb.r]
+	return
+}
+
+// ReadFrom reads from r directly into the ring-buffer's free segments,
+// growing the underlying buffer as needed, until r returns io.EOF. It
+// implements io.ReaderFrom.
+func (rb *RingBuffer) ReadFrom(r io.Reader) (n int64, err error) {
+	for {
+		if rb.Free() == 0 {
+			hadData := !rb.isEmpty
+			rb.malloc(1)
+			if hadData {
+				// malloc drains the old buffer through Read, which marks
+				// the buffer empty once fully copied out; the grown
+				// buffer still holds that data, so restore the flag.
+				rb.isEmpty = false
+			}
+			if rb.Free() == 0 {
+				// MaxSize capped growth; stop rather than spin forever.
+				return n, ErrMaxSizeExceeded
+			}
+		}
+
+		head, tail := rb.freeSegments()
+
+		var rn int
+		if len(head) > 0 {
+			rn, err = r.Read(head)
+		} else {
+			rn, err = r.Read(tail)
+		}
+
+		if rn > 0 {
+			rb.w += rn
+			if rb.w == rb.size {
+				rb.w = 0
+			}
+			rb.isEmpty = false
+			n += int64(rn)
+		}
+
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return n, err
+		}
+	}
+}
+
+// WriteTo writes all readable data to w, one call per contiguous segment
+// returned by LazyReadAll, advancing the read pointer past whatever was
+// successfully written. It implements io.WriterTo.
+func (rb *RingBuffer) WriteTo(w io.Writer) (n int64, err error) {
+	head, tail := rb.LazyReadAll()
+
+	if len(head) > 0 {
+		hn, werr := w.Write(head)
+		n += int64(hn)
+		if werr != nil {
+			rb.Shift(hn)
+			return n, werr
+		}
+	}
+
+	if len(tail) > 0 {
+		tn, werr := w.Write(tail)
+		n += int64(tn)
+		if werr != nil {
+			rb.Shift(len(head) + tn)
+			return n, werr
+		}
+	}
+
+	rb.Shift(int(n))
+	return n, nil
+}
@@ -0,0 +1,38 @@
+package ringbuffer
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRingBufferReadFromWriteTo(t *testing.T) {
+	rb := NewRingBuffer(4)
+
+	src := bytes.NewReader([]byte("the quick brown fox"))
+	n, err := rb.ReadFrom(src)
+	if err != nil || n != 19 {
+		t.Fatalf("ReadFrom() = (%d, %v), want (19, nil)", n, err)
+	}
+
+	var out bytes.Buffer
+	wn, err := rb.WriteTo(&out)
+	if err != nil || wn != 19 || out.String() != "the quick brown fox" {
+		t.Fatalf("WriteTo() = (%d, %v, %q), want (19, nil, \"the quick brown fox\")", wn, err, out.String())
+	}
+	if !rb.IsEmpty() {
+		t.Fatalf("IsEmpty() = false after WriteTo drained everything")
+	}
+}
+
+func TestRingBufferWriteToWrapsAroundSegments(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abcdef"))
+	rb.Shift(4) // leave "ef" and move r/w so the next write wraps
+	rb.Write([]byte("ghij"))
+
+	var out bytes.Buffer
+	n, err := rb.WriteTo(&out)
+	if err != nil || n != 6 || out.String() != "efghij" {
+		t.Fatalf("WriteTo() = (%d, %v, %q), want (6, nil, \"efghij\")", n, err, out.String())
+	}
+}
@@ -0,0 +1,110 @@
+package ringbuffer
+
+import "testing"
+
+func TestRingBufferShiftDoesNotReallocate(t *testing.T) {
+	rb := NewRingBuffer(8)
+	rb.Write([]byte("abcd"))
+
+	before := rb.Len()
+	rb.Shift(4)
+	if rb.Len() != before {
+		t.Fatalf("Shift reallocated: Len() went from %d to %d", before, rb.Len())
+	}
+	if !rb.IsEmpty() {
+		t.Fatalf("IsEmpty() = false after Shift drained everything")
+	}
+}
+
+func TestRingBufferShrinkPreservesData(t *testing.T) {
+	rb := NewRingBuffer(1 << 14)
+	rb.Write([]byte("hello world this has some bytes"))
+
+	rb.Shrink()
+
+	if rb.IsEmpty() {
+		t.Fatalf("IsEmpty() = true after Shrink, want false")
+	}
+	buf := make([]byte, 64)
+	n, err := rb.Read(buf)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if string(buf[:n]) != "hello world this has some bytes" {
+		t.Fatalf("Read() = %q, want %q", buf[:n], "hello world this has some bytes")
+	}
+}
+
+func TestRingBufferShrinkNoopWhenDataWouldNotFit(t *testing.T) {
+	rb := NewRingBuffer(1 << 13) // 8192
+	rb.Write(make([]byte, 5000))
+
+	before := rb.Cap()
+	rb.Shrink() // halved (4096) can't hold 5000 bytes
+	if rb.Cap() != before {
+		t.Fatalf("Cap() = %d, want unchanged %d", rb.Cap(), before)
+	}
+}
+
+func TestRingBufferTryShrinkNeedsConsecutiveLowWaterDrains(t *testing.T) {
+	rb := NewRingBufferWithOptions(1<<14, Options{ShrinkThreshold: 0.5})
+
+	before := rb.Cap()
+	for i := 0; i < minShrinkStreak-1; i++ {
+		rb.Write([]byte("x"))
+		rb.Shift(1)
+		if rb.Cap() != before {
+			t.Fatalf("Cap() shrank after only %d drains, want %d", i+1, minShrinkStreak)
+		}
+	}
+
+	rb.Write([]byte("x"))
+	rb.Shift(1)
+	if rb.Cap() >= before {
+		t.Fatalf("Cap() = %d, want it to have shrunk after %d consecutive low-water drains", rb.Cap(), minShrinkStreak)
+	}
+}
+
+func TestRingBufferAutoShrinkViaRead(t *testing.T) {
+	rb := NewRingBufferWithOptions(1<<14, Options{ShrinkThreshold: 0.5})
+
+	before := rb.Cap()
+	buf := make([]byte, 1)
+	for i := 0; i < minShrinkStreak; i++ {
+		rb.Write([]byte("x"))
+		if _, err := rb.Read(buf); err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+	}
+
+	if rb.Cap() >= before {
+		t.Fatalf("Cap() = %d, want it to have shrunk after %d consecutive low-water drains via Read, not just Shift", rb.Cap(), minShrinkStreak)
+	}
+}
+
+func TestRingBufferPlainConstructorNeverAutoShrinks(t *testing.T) {
+	rb := NewRingBuffer(1 << 14)
+	before := rb.Cap()
+	for i := 0; i < 10; i++ {
+		rb.Write([]byte("x"))
+		rb.Shift(1)
+	}
+	if rb.Cap() != before {
+		t.Fatalf("Cap() = %d, want unchanged %d (auto-shrink must be opt-in)", rb.Cap(), before)
+	}
+}
+
+func TestRingBufferMaxSizeCapsGrowth(t *testing.T) {
+	rb := NewRingBufferWithOptions(0, Options{MaxSize: 1000})
+
+	n, err := rb.Write(make([]byte, 5000))
+	if err != ErrMaxSizeExceeded {
+		t.Fatalf("Write() error = %v, want ErrMaxSizeExceeded", err)
+	}
+	if n >= 5000 {
+		t.Fatalf("Write() n = %d, want a short write", n)
+	}
+	if rb.Cap() > CeilToPowerOfTwo(1000) {
+		t.Fatalf("Cap() = %d, exceeded the MaxSize ceiling", rb.Cap())
+	}
+}
@@ -0,0 +1,141 @@
+package ringbuffer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrClosed is returned by WriteContext once Close has been called.
+var ErrClosed = errors.New("ring-buffer is closed")
+
+// SyncRingBuffer is a goroutine-safe wrapper around RingBuffer that blocks
+// on ReadContext/WriteContext instead of returning ErrIsEmpty or silently
+// growing, making it suitable for a single-producer/single-consumer pipe.
+type SyncRingBuffer struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	rb       *RingBuffer
+	closed   bool
+}
+
+// NewSyncRingBuffer creates a SyncRingBuffer backed by a RingBuffer of the given size.
+func NewSyncRingBuffer(size int) *SyncRingBuffer {
+	s := &SyncRingBuffer{
+		rb: NewRingBuffer(size),
+	}
+	s.notEmpty = sync.NewCond(&s.mu)
+	s.notFull = sync.NewCond(&s.mu)
+	return s
+}
+
+// watch spawns a goroutine that wakes cond once ctx is done, so a blocked
+// Wait can observe context cancellation. The returned channel must be
+// closed once the caller is no longer waiting on cond.
+func (s *SyncRingBuffer) watch(ctx context.Context, cond *sync.Cond) chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			cond.Broadcast()
+			s.mu.Unlock()
+		case <-done:
+		}
+	}()
+	return done
+}
+
+// ReadContext blocks until data is available, ctx is done, or the buffer is
+// closed and drained, in which case it returns io.EOF.
+func (s *SyncRingBuffer) ReadContext(ctx context.Context, p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	done := s.watch(ctx, s.notEmpty)
+	defer close(done)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for s.rb.IsEmpty() && !s.closed {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		s.notEmpty.Wait()
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if s.rb.IsEmpty() {
+		return 0, io.EOF
+	}
+
+	n, err := s.rb.Read(p)
+	if err == nil {
+		s.notFull.Signal()
+	}
+	return n, err
+}
+
+// WriteContext blocks while the buffer is full, writing as room becomes
+// available, until all of p has been written, ctx is done, or the buffer
+// is closed.
+func (s *SyncRingBuffer) WriteContext(ctx context.Context, p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	done := s.watch(ctx, s.notFull)
+	defer close(done)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	written := 0
+	for written < len(p) {
+		for s.rb.Free() == 0 && !s.closed {
+			if err := ctx.Err(); err != nil {
+				return written, err
+			}
+			s.notFull.Wait()
+		}
+
+		if err := ctx.Err(); err != nil {
+			return written, err
+		}
+		if s.closed {
+			return written, ErrClosed
+		}
+
+		chunk := len(p) - written
+		if free := s.rb.Free(); chunk > free {
+			chunk = free
+		}
+		s.rb.copyIn(p[written : written+chunk])
+		written += chunk
+		s.notEmpty.Signal()
+	}
+
+	return written, nil
+}
+
+// Close unblocks any pending ReadContext/WriteContext calls. Pending reads
+// drain the remaining buffered data before returning io.EOF; pending and
+// future writes return ErrClosed.
+func (s *SyncRingBuffer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	s.notEmpty.Broadcast()
+	s.notFull.Broadcast()
+	return nil
+}
@@ -0,0 +1,109 @@
+package ringbuffer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestSyncRingBufferWriteThenRead(t *testing.T) {
+	s := NewSyncRingBuffer(16)
+	ctx := context.Background()
+
+	n, err := s.WriteContext(ctx, []byte("hello"))
+	if err != nil || n != 5 {
+		t.Fatalf("WriteContext() = (%d, %v), want (5, nil)", n, err)
+	}
+
+	buf := make([]byte, 16)
+	n, err = s.ReadContext(ctx, buf)
+	if err != nil || string(buf[:n]) != "hello" {
+		t.Fatalf("ReadContext() = (%q, %v), want (\"hello\", nil)", buf[:n], err)
+	}
+}
+
+func TestSyncRingBufferReadBlocksUntilWrite(t *testing.T) {
+	s := NewSyncRingBuffer(16)
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	var n int
+	var err error
+	buf := make([]byte, 16)
+	go func() {
+		n, err = s.ReadContext(ctx, buf)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("ReadContext returned before any data was written")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if _, werr := s.WriteContext(ctx, []byte("ok")); werr != nil {
+		t.Fatalf("WriteContext() error = %v", werr)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("ReadContext never returned after write")
+	}
+	if err != nil || string(buf[:n]) != "ok" {
+		t.Fatalf("ReadContext() = (%q, %v), want (\"ok\", nil)", buf[:n], err)
+	}
+}
+
+func TestSyncRingBufferCloseUnblocksRead(t *testing.T) {
+	s := NewSyncRingBuffer(16)
+	ctx := context.Background()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := s.ReadContext(ctx, make([]byte, 16))
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	s.Close()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, io.EOF) {
+			t.Fatalf("ReadContext() error = %v, want io.EOF", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ReadContext never unblocked after Close")
+	}
+}
+
+func TestSyncRingBufferWriteContextCtxCancel(t *testing.T) {
+	s := NewSyncRingBuffer(2)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Fill the buffer so the next write has to block.
+	if _, err := s.WriteContext(context.Background(), []byte("xy")); err != nil {
+		t.Fatalf("WriteContext() error = %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := s.WriteContext(ctx, []byte("z"))
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("WriteContext() error = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WriteContext never unblocked after ctx cancellation")
+	}
+}
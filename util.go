@@ -0,0 +1,33 @@
+/*
+@Time : 2021/6/16 下午6:02
+@Author : MuYiMing
+@File : util
+@Software: GoLand
+*/
+package ringbuffer
+
+import "unsafe"
+
+// CeilToPowerOfTwo returns the smallest power of two that is >= n.
+func CeilToPowerOfTwo(n int) int {
+	if n <= 0 {
+		return 1
+	}
+
+	n--
+	n |= n >> 1
+	n |= n >> 2
+	n |= n >> 4
+	n |= n >> 8
+	n |= n >> 16
+	n |= n >> 32
+	n++
+
+	return n
+}
+
+// StringToBytes converts s to a []byte without copying the underlying
+// data. The returned slice must not be mutated.
+func StringToBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}